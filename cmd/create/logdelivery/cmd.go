@@ -0,0 +1,139 @@
+/*
+Copyright (c) 2020 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logdelivery
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+var args struct {
+	clusterKey     string
+	source         string
+	destinationARN string
+	dryRun         bool
+}
+
+var Cmd = &cobra.Command{
+	Use:     "log-delivery",
+	Aliases: []string{"log-deliveries"},
+	Short:   "Pair a delivery source and delivery destination",
+	Long: "Pair a previously created delivery source with a delivery destination, starting the " +
+		"flow of cluster or add-on logs to a CloudWatch Logs destination in another AWS account.",
+	Example: `  # Deliver the "mycluster-audit" source to a destination in another account
+  rosa create log-delivery --cluster mycluster --source mycluster-audit \
+    --destination-arn arn:aws:logs:us-east-1:222222222222:delivery-destination:my-destination`,
+	Run: run,
+}
+
+func init() {
+	flags := Cmd.Flags()
+
+	flags.StringVarP(
+		&args.clusterKey,
+		"cluster",
+		"c",
+		"",
+		"Name or ID of the cluster the delivery belongs to (required).",
+	)
+
+	flags.StringVar(
+		&args.source,
+		"source",
+		"",
+		"Name of the delivery source to deliver logs from (required).",
+	)
+
+	flags.StringVar(
+		&args.destinationARN,
+		"destination-arn",
+		"",
+		"ARN of the delivery destination to deliver logs to (required).",
+	)
+
+	flags.BoolVar(
+		&args.dryRun,
+		"dry-run",
+		false,
+		"Print the delivery that would be created, without creating it.",
+	)
+}
+
+func run(_ *cobra.Command, _ []string) {
+	r := rosa.NewRuntime().WithAWS().WithOCM()
+	defer r.Cleanup()
+
+	clusterKey := args.clusterKey
+	if clusterKey == "" {
+		r.Reporter.Errorf("--cluster is required")
+		os.Exit(1)
+	}
+	if !ocm.IsValidClusterKey(clusterKey) {
+		r.Reporter.Errorf(
+			"Cluster name, identifier or external identifier '%s' isn't valid: it "+
+				"must contain only letters, digits, dashes and underscores",
+			clusterKey,
+		)
+		os.Exit(1)
+	}
+
+	if args.source == "" || args.destinationARN == "" {
+		r.Reporter.Errorf("--source and --destination-arn are required")
+		os.Exit(1)
+	}
+
+	cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+	if err != nil {
+		r.Reporter.Errorf("Failed to get cluster '%s': %v", clusterKey, err)
+		os.Exit(1)
+	}
+
+	if args.dryRun {
+		fmt.Printf("Would deliver logs from source '%s' to destination '%s' for cluster '%s'\n",
+			args.source, args.destinationARN, clusterKey)
+		os.Exit(0)
+	}
+
+	client := aws.NewLogDeliveryClient(r.AWSClient.GetConfig())
+
+	r.Reporter.Debugf("Creating delivery from source '%s' to destination '%s'", args.source, args.destinationARN)
+	delivery, err := client.CreateDelivery(context.Background(), args.source, args.destinationARN)
+	if err != nil {
+		r.Reporter.Errorf("Failed to create delivery: %v", err)
+		os.Exit(1)
+	}
+	if delivery.Id == nil {
+		r.Reporter.Errorf("CloudWatch Logs did not return an ID for the new delivery")
+		os.Exit(1)
+	}
+
+	r.Reporter.Debugf("Registering delivery '%s' with OCM", *delivery.Id)
+	err = r.OCMClient.CreateLogDelivery(cluster.ID(), *delivery.Id, args.source, args.destinationARN)
+	if err != nil {
+		r.Reporter.Errorf("Failed to register delivery '%s' with OCM: %v", *delivery.Id, err)
+		os.Exit(1)
+	}
+
+	r.Reporter.Infof("Created delivery '%s'", *delivery.Id)
+}
@@ -0,0 +1,125 @@
+/*
+Copyright (c) 2020 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logdeliverydestination
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+var args struct {
+	name           string
+	destinationARN string
+	sourceAccount  string
+	dryRun         bool
+}
+
+var Cmd = &cobra.Command{
+	Use:     "log-delivery-destination",
+	Aliases: []string{"log-delivery-destinations"},
+	Short:   "Create a CloudWatch Logs delivery destination",
+	Long: "Create a CloudWatch Logs delivery destination that points at a log group in another " +
+		"AWS account, and attach the cross-account resource policy required for delivery to reach it.",
+	Example: `  # Create a delivery destination for the "central-logging" account
+  rosa create log-delivery-destination --name my-destination \
+    --destination-arn arn:aws:logs:us-east-1:222222222222:log-group:central-logs \
+    --source-account 111111111111`,
+	Run: run,
+}
+
+func init() {
+	flags := Cmd.Flags()
+
+	flags.StringVar(
+		&args.name,
+		"name",
+		"",
+		"Name of the delivery destination (required).",
+	)
+
+	flags.StringVar(
+		&args.destinationARN,
+		"destination-arn",
+		"",
+		"ARN of the log group in the destination account that logs should be delivered to (required).",
+	)
+
+	flags.StringVar(
+		&args.sourceAccount,
+		"source-account",
+		"",
+		"AWS account ID that deliveries will originate from (required).",
+	)
+
+	flags.BoolVar(
+		&args.dryRun,
+		"dry-run",
+		false,
+		"Print the IAM resource policy that would be attached to the destination, without applying it.",
+	)
+}
+
+func run(_ *cobra.Command, _ []string) {
+	r := rosa.NewRuntime().WithAWS()
+	defer r.Cleanup()
+
+	if args.name == "" || args.destinationARN == "" || args.sourceAccount == "" {
+		r.Reporter.Errorf("--name, --destination-arn and --source-account are required")
+		os.Exit(1)
+	}
+
+	policy, err := aws.BuildDeliveryDestinationPolicy(args.destinationARN, args.sourceAccount)
+	if err != nil {
+		r.Reporter.Errorf("%v", err)
+		os.Exit(1)
+	}
+
+	if args.dryRun {
+		fmt.Println(policy)
+		os.Exit(0)
+	}
+
+	client := aws.NewLogDeliveryClient(r.AWSClient.GetConfig())
+
+	ctx := context.Background()
+
+	r.Reporter.Debugf("Creating delivery destination '%s'", args.name)
+	destination, err := client.PutDeliveryDestination(ctx, args.name, args.destinationARN)
+	if err != nil {
+		r.Reporter.Errorf("Failed to create delivery destination '%s': %v", args.name, err)
+		os.Exit(1)
+	}
+	if destination.Arn == nil {
+		r.Reporter.Errorf("CloudWatch Logs did not return an ARN for delivery destination '%s'", args.name)
+		os.Exit(1)
+	}
+
+	r.Reporter.Debugf("Attaching cross-account policy to delivery destination '%s'", args.name)
+	err = client.PutDeliveryDestinationPolicy(ctx, args.name, policy)
+	if err != nil {
+		r.Reporter.Errorf("Failed to attach policy to delivery destination '%s': %v", args.name, err)
+		os.Exit(1)
+	}
+
+	r.Reporter.Infof("Created delivery destination '%s' (%s)", args.name, *destination.Arn)
+}
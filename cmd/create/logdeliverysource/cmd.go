@@ -0,0 +1,145 @@
+/*
+Copyright (c) 2020 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logdeliverysource
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+var args struct {
+	clusterKey  string
+	name        string
+	logType     string
+	resourceARN string
+}
+
+var Cmd = &cobra.Command{
+	Use:     "log-delivery-source",
+	Aliases: []string{"log-delivery-sources"},
+	Short:   "Create a CloudWatch Logs delivery source for a cluster",
+	Long: "Create a CloudWatch Logs delivery source representing a cluster's audit logs, " +
+		"infrastructure logs, or an installed add-on's logs, so that they can be paired with a " +
+		"delivery destination in another account.",
+	Example: `  # Create a delivery source for the audit logs of cluster "mycluster"
+  rosa create log-delivery-source --cluster mycluster --name mycluster-audit --log-type audit \
+    --resource-arn arn:aws:logs:us-east-1:111111111111:log-group:mycluster-audit`,
+	Run: run,
+}
+
+func init() {
+	flags := Cmd.Flags()
+
+	flags.StringVarP(
+		&args.clusterKey,
+		"cluster",
+		"c",
+		"",
+		"Name or ID of the cluster to create the delivery source for (required).",
+	)
+
+	flags.StringVar(
+		&args.name,
+		"name",
+		"",
+		"Name of the delivery source (required).",
+	)
+
+	flags.StringVar(
+		&args.logType,
+		"log-type",
+		"",
+		"Logs to deliver: 'audit', 'infrastructure', or 'addon:<id>' for an installed add-on (required).",
+	)
+
+	flags.StringVar(
+		&args.resourceARN,
+		"resource-arn",
+		"",
+		"ARN of the AWS resource that is actually producing the logs, e.g. the CloudWatch Logs "+
+			"log group the cluster or add-on writes to (required).",
+	)
+}
+
+func run(_ *cobra.Command, _ []string) {
+	r := rosa.NewRuntime().WithAWS().WithOCM()
+	defer r.Cleanup()
+
+	clusterKey := args.clusterKey
+	if clusterKey == "" {
+		r.Reporter.Errorf("--cluster is required")
+		os.Exit(1)
+	}
+	if !ocm.IsValidClusterKey(clusterKey) {
+		r.Reporter.Errorf(
+			"Cluster name, identifier or external identifier '%s' isn't valid: it "+
+				"must contain only letters, digits, dashes and underscores",
+			clusterKey,
+		)
+		os.Exit(1)
+	}
+
+	if args.name == "" {
+		r.Reporter.Errorf("--name is required")
+		os.Exit(1)
+	}
+
+	if args.resourceARN == "" {
+		r.Reporter.Errorf("--resource-arn is required")
+		os.Exit(1)
+	}
+
+	if args.logType != "audit" && args.logType != "infrastructure" && !strings.HasPrefix(args.logType, "addon:") {
+		r.Reporter.Errorf("--log-type must be 'audit', 'infrastructure', or 'addon:<id>'")
+		os.Exit(1)
+	}
+
+	cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+	if err != nil {
+		r.Reporter.Errorf("Failed to get cluster '%s': %v", clusterKey, err)
+		os.Exit(1)
+	}
+
+	client := aws.NewLogDeliveryClient(r.AWSClient.GetConfig())
+
+	r.Reporter.Debugf("Creating delivery source '%s' for cluster '%s'", args.name, clusterKey)
+	source, err := client.PutDeliverySource(context.Background(), args.name, args.resourceARN, args.logType)
+	if err != nil {
+		r.Reporter.Errorf("Failed to create delivery source '%s': %v", args.name, err)
+		os.Exit(1)
+	}
+	if source.Arn == nil {
+		r.Reporter.Errorf("CloudWatch Logs did not return an ARN for delivery source '%s'", args.name)
+		os.Exit(1)
+	}
+
+	r.Reporter.Debugf("Registering delivery source '%s' with OCM", args.name)
+	err = r.OCMClient.CreateLogDeliverySource(cluster.ID(), args.name, args.logType)
+	if err != nil {
+		r.Reporter.Errorf("Failed to register delivery source '%s' with OCM: %v", args.name, err)
+		os.Exit(1)
+	}
+
+	r.Reporter.Infof("Created delivery source '%s' (%s)", args.name, *source.Arn)
+}
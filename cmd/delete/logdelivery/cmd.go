@@ -0,0 +1,122 @@
+/*
+Copyright (c) 2020 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logdelivery
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+var args struct {
+	clusterKey string
+	id         string
+	yes        bool
+}
+
+var Cmd = &cobra.Command{
+	Use:     "log-delivery",
+	Aliases: []string{"log-deliveries"},
+	Short:   "Delete a CloudWatch Logs delivery",
+	Long:    "Delete a CloudWatch Logs delivery, stopping the flow of log events to its destination.",
+	Example: `  # Delete a delivery
+  rosa delete log-delivery --cluster mycluster --id abcd1234`,
+	Run: run,
+}
+
+func init() {
+	flags := Cmd.Flags()
+
+	flags.StringVarP(
+		&args.clusterKey,
+		"cluster",
+		"c",
+		"",
+		"Name or ID of the cluster the delivery belongs to (required).",
+	)
+
+	flags.StringVar(
+		&args.id,
+		"id",
+		"",
+		"ID of the delivery to delete (required).",
+	)
+
+	flags.BoolVarP(
+		&args.yes,
+		"yes",
+		"y",
+		false,
+		"Skip interactive confirmation and delete the delivery immediately.",
+	)
+}
+
+func run(_ *cobra.Command, _ []string) {
+	r := rosa.NewRuntime().WithAWS().WithOCM()
+	defer r.Cleanup()
+
+	clusterKey := args.clusterKey
+	if clusterKey == "" {
+		r.Reporter.Errorf("--cluster is required")
+		os.Exit(1)
+	}
+	if !ocm.IsValidClusterKey(clusterKey) {
+		r.Reporter.Errorf(
+			"Cluster name, identifier or external identifier '%s' isn't valid: it "+
+				"must contain only letters, digits, dashes and underscores",
+			clusterKey,
+		)
+		os.Exit(1)
+	}
+
+	if args.id == "" {
+		r.Reporter.Errorf("--id is required")
+		os.Exit(1)
+	}
+
+	if !args.yes && !r.Reporter.Question("Are you sure you want to delete log delivery '%s'?", args.id) {
+		os.Exit(0)
+	}
+
+	cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+	if err != nil {
+		r.Reporter.Errorf("Failed to get cluster '%s': %v", clusterKey, err)
+		os.Exit(1)
+	}
+
+	client := aws.NewLogDeliveryClient(r.AWSClient.GetConfig())
+
+	err = client.DeleteDelivery(context.Background(), args.id)
+	if err != nil {
+		r.Reporter.Errorf("Failed to delete delivery '%s': %v", args.id, err)
+		os.Exit(1)
+	}
+
+	r.Reporter.Debugf("Deregistering delivery '%s' from OCM", args.id)
+	err = r.OCMClient.DeleteLogDelivery(cluster.ID(), args.id)
+	if err != nil {
+		r.Reporter.Errorf("Failed to deregister delivery '%s' from OCM: %v", args.id, err)
+		os.Exit(1)
+	}
+
+	r.Reporter.Infof("Deleted delivery '%s'", args.id)
+}
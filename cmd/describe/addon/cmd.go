@@ -0,0 +1,237 @@
+/*
+Copyright (c) 2020 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+var args struct {
+	clusterKey string
+	parameter  string
+}
+
+// parameterOutput describes a single add-on parameter's schema alongside the
+// value (if any) currently configured on the cluster.
+type parameterOutput struct {
+	Name          string   `json:"name" yaml:"name"`
+	Type          string   `json:"type" yaml:"type"`
+	Required      bool     `json:"required" yaml:"required"`
+	AllowedValues []string `json:"allowed_values,omitempty" yaml:"allowed_values,omitempty"`
+	Default       string   `json:"default,omitempty" yaml:"default,omitempty"`
+	Value         string   `json:"value,omitempty" yaml:"value,omitempty"`
+}
+
+// addonOutput is the structured representation printed by this command,
+// backing both the human readable view and `--output json|yaml`.
+type addonOutput struct {
+	ID                string            `json:"id" yaml:"id"`
+	Name              string            `json:"name" yaml:"name"`
+	State             string            `json:"state" yaml:"state"`
+	RequiredAddOns    []string          `json:"required_addons,omitempty" yaml:"required_addons,omitempty"`
+	SubOperators      []string          `json:"sub_operators,omitempty" yaml:"sub_operators,omitempty"`
+	ResourceQuotaCost int               `json:"resource_quota_cost" yaml:"resource_quota_cost"`
+	Parameters        []parameterOutput `json:"parameters" yaml:"parameters"`
+}
+
+var Cmd = &cobra.Command{
+	Use:     "addon [ID]",
+	Aliases: []string{"add-on"},
+	Short:   "Show details of an installed add-on",
+	Long: "Show an add-on's parameter schema alongside the values currently configured on the " +
+		"cluster, its prerequisites, sub-operator requirements and quota impact.",
+	Example: `  # Describe the "dbaas-operator" add-on installed on cluster "mycluster"
+  rosa describe addon dbaas-operator --cluster mycluster`,
+	Args: cobra.ExactArgs(1),
+	Run:  run,
+}
+
+func init() {
+	flags := Cmd.Flags()
+
+	flags.StringVarP(
+		&args.clusterKey,
+		"cluster",
+		"c",
+		"",
+		"Name or ID of the cluster the add-on is installed on (required).",
+	)
+
+	flags.StringVar(
+		&args.parameter,
+		"parameter",
+		"",
+		"Show only the schema and value of this parameter.",
+	)
+
+	output.AddFlag(Cmd)
+}
+
+func run(cmd *cobra.Command, argv []string) {
+	r := rosa.NewRuntime().WithAWS().WithOCM()
+	defer r.Cleanup()
+
+	addOnID := argv[0]
+
+	outputFormat, err := output.Get(cmd)
+	if err != nil {
+		r.Reporter.Errorf("%v", err)
+		os.Exit(1)
+	}
+
+	clusterKey := args.clusterKey
+	if clusterKey == "" {
+		r.Reporter.Errorf("--cluster is required")
+		os.Exit(1)
+	}
+	if !ocm.IsValidClusterKey(clusterKey) {
+		r.Reporter.Errorf(
+			"Cluster name, identifier or external identifier '%s' isn't valid: it "+
+				"must contain only letters, digits, dashes and underscores",
+			clusterKey,
+		)
+		os.Exit(1)
+	}
+
+	cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+	if err != nil {
+		r.Reporter.Errorf("Failed to get cluster '%s': %v", clusterKey, err)
+		os.Exit(1)
+	}
+
+	addOn, err := r.OCMClient.GetAddOn(addOnID)
+	if err != nil {
+		r.Reporter.Errorf("Failed to get add-on '%s': %v", addOnID, err)
+		os.Exit(1)
+	}
+
+	installation, err := r.OCMClient.GetClusterAddOnInstallation(cluster, addOnID)
+	if err != nil {
+		r.Reporter.Errorf("Failed to get add-on '%s' installation on cluster '%s': %v", addOnID, clusterKey, err)
+		os.Exit(1)
+	}
+
+	parameters := buildParameterOutputs(addOnParameterSchemas(addOn), installation.Parameters)
+	if args.parameter != "" {
+		filtered, found := filterParameter(parameters, args.parameter)
+		if !found {
+			r.Reporter.Errorf("Add-on '%s' has no parameter named '%s'", addOnID, args.parameter)
+			os.Exit(1)
+		}
+		parameters = filtered
+	}
+
+	result := addonOutput{
+		ID:                addOn.ID(),
+		Name:              addOn.Name(),
+		State:             installation.State,
+		RequiredAddOns:    addOn.RequiredAddOnIDs(),
+		SubOperators:      addOn.SubOperatorNames(),
+		ResourceQuotaCost: addOn.ResourceCost(),
+		Parameters:        parameters,
+	}
+
+	if outputFormat != output.Table {
+		if err := output.Print(outputFormat, result); err != nil {
+			r.Reporter.Errorf("%v", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	printTable(result)
+}
+
+// parameterSchema is the declared schema of a single add-on parameter,
+// independent of the OCM SDK types it's read from, so that merging it with
+// configured values can be unit tested without an OCM client.
+type parameterSchema struct {
+	Name          string
+	Type          string
+	Required      bool
+	AllowedValues []string
+	Default       string
+}
+
+// addOnParameterSchemas converts an add-on's declared parameters into the
+// package-local parameterSchema form.
+func addOnParameterSchemas(addOn *ocm.AddOn) []parameterSchema {
+	schema := addOn.Parameters()
+	schemas := make([]parameterSchema, 0, len(schema))
+	for _, p := range schema {
+		schemas = append(schemas, parameterSchema{
+			Name:          p.Name,
+			Type:          p.Type,
+			Required:      p.Required,
+			AllowedValues: p.AllowedValues,
+			Default:       p.Default,
+		})
+	}
+	return schemas
+}
+
+// buildParameterOutputs merges an add-on's declared parameter schema with
+// the values currently configured on the cluster.
+func buildParameterOutputs(schema []parameterSchema, values map[string]string) []parameterOutput {
+	parameters := make([]parameterOutput, 0, len(schema))
+	for _, p := range schema {
+		parameters = append(parameters, parameterOutput{
+			Name:          p.Name,
+			Type:          p.Type,
+			Required:      p.Required,
+			AllowedValues: p.AllowedValues,
+			Default:       p.Default,
+			Value:         values[p.Name],
+		})
+	}
+	return parameters
+}
+
+// filterParameter narrows parameters down to the one named by name. The
+// second return value is false if no parameter with that name exists.
+func filterParameter(parameters []parameterOutput, name string) ([]parameterOutput, bool) {
+	filtered := make([]parameterOutput, 0, 1)
+	for _, parameter := range parameters {
+		if parameter.Name == name {
+			filtered = append(filtered, parameter)
+		}
+	}
+	return filtered, len(filtered) > 0
+}
+
+func printTable(result addonOutput) {
+	fmt.Printf("ID:                 %s\n", result.ID)
+	fmt.Printf("Name:               %s\n", result.Name)
+	fmt.Printf("State:              %s\n", result.State)
+	fmt.Printf("Required add-ons:   %v\n", result.RequiredAddOns)
+	fmt.Printf("Sub-operators:      %v\n", result.SubOperators)
+	fmt.Printf("Resource quota cost: %d\n", result.ResourceQuotaCost)
+	fmt.Println("Parameters:")
+	for _, parameter := range result.Parameters {
+		fmt.Printf("  - %s (%s, required=%t)\n", parameter.Name, parameter.Type, parameter.Required)
+		fmt.Printf("    Default:        %s\n", parameter.Default)
+		fmt.Printf("    Allowed values: %v\n", parameter.AllowedValues)
+		fmt.Printf("    Value:          %s\n", parameter.Value)
+	}
+}
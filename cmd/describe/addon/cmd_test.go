@@ -0,0 +1,82 @@
+/*
+Copyright (c) 2020 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuildParameterOutputs(t *testing.T) {
+	schema := []parameterSchema{
+		{Name: "region", Type: "string", Required: true, AllowedValues: []string{"us-east-1", "eu-west-1"}, Default: "us-east-1"},
+		{Name: "replicas", Type: "integer", Required: false, Default: "3"},
+	}
+	values := map[string]string{"region": "eu-west-1"}
+
+	got := buildParameterOutputs(schema, values)
+	want := []parameterOutput{
+		{
+			Name:          "region",
+			Type:          "string",
+			Required:      true,
+			AllowedValues: []string{"us-east-1", "eu-west-1"},
+			Default:       "us-east-1",
+			Value:         "eu-west-1",
+		},
+		{
+			Name:     "replicas",
+			Type:     "integer",
+			Required: false,
+			Default:  "3",
+			Value:    "",
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("buildParameterOutputs() = %+v, want %+v", got, want)
+	}
+}
+
+func TestFilterParameter(t *testing.T) {
+	parameters := []parameterOutput{
+		{Name: "region", Value: "eu-west-1"},
+		{Name: "replicas", Value: "3"},
+	}
+
+	cases := []struct {
+		name      string
+		filter    string
+		wantFound bool
+		wantLen   int
+	}{
+		{name: "matching parameter", filter: "replicas", wantFound: true, wantLen: 1},
+		{name: "unknown parameter", filter: "bogus", wantFound: false, wantLen: 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			filtered, found := filterParameter(parameters, c.filter)
+			if found != c.wantFound {
+				t.Fatalf("filterParameter(%q) found = %v, want %v", c.filter, found, c.wantFound)
+			}
+			if len(filtered) != c.wantLen {
+				t.Fatalf("filterParameter(%q) returned %d parameters, want %d", c.filter, len(filtered), c.wantLen)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright (c) 2020 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logdelivery
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+var args struct {
+	id string
+}
+
+var Cmd = &cobra.Command{
+	Use:     "log-delivery",
+	Aliases: []string{"log-deliveries"},
+	Short:   "Show details of a CloudWatch Logs delivery",
+	Long:    "Show the ID, source, destination and destination type of a single CloudWatch Logs delivery.",
+	Example: `  # Describe a delivery
+  rosa describe log-delivery --id abcd1234`,
+	Run: run,
+}
+
+func init() {
+	flags := Cmd.Flags()
+
+	flags.StringVar(
+		&args.id,
+		"id",
+		"",
+		"ID of the delivery to describe (required).",
+	)
+}
+
+func run(_ *cobra.Command, _ []string) {
+	r := rosa.NewRuntime().WithAWS()
+	defer r.Cleanup()
+
+	if args.id == "" {
+		r.Reporter.Errorf("--id is required")
+		os.Exit(1)
+	}
+
+	client := aws.NewLogDeliveryClient(r.AWSClient.GetConfig())
+
+	delivery, err := client.GetDelivery(context.Background(), args.id)
+	if err != nil {
+		r.Reporter.Errorf("Failed to get delivery '%s': %v", args.id, err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("ID:                     %s\n", aws.StringValue(delivery.Id))
+	fmt.Printf("Source:                 %s\n", aws.StringValue(delivery.DeliverySourceName))
+	fmt.Printf("Destination:            %s\n", aws.StringValue(delivery.DeliveryDestinationArn))
+	fmt.Printf("Destination type:       %s\n", delivery.DeliveryDestinationType)
+}
@@ -20,16 +20,45 @@ import (
 	"fmt"
 	"os"
 	"text/tabwriter"
+	"time"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
 var args struct {
 	clusterKey string
+	watch      bool
+	interval   time.Duration
+	wait       bool
+	addonID    string
+	forState   string
+	timeout    time.Duration
+}
+
+// availableAddOnOutput is the structured representation of an add-on that is
+// available for installation, used to back both the table and the
+// `--output json|yaml` views.
+type availableAddOnOutput struct {
+	ID           string `json:"id" yaml:"id"`
+	Name         string `json:"name" yaml:"name"`
+	Availability string `json:"availability" yaml:"availability"`
+}
+
+// installedAddOnOutput is the structured representation of an add-on that is
+// installed on a cluster, used to back both the table and the
+// `--output json|yaml` views.
+type installedAddOnOutput struct {
+	ID         string            `json:"id" yaml:"id"`
+	Name       string            `json:"name" yaml:"name"`
+	State      string            `json:"state" yaml:"state"`
+	CreatedAt  string            `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	UpdatedAt  string            `json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
+	Parameters map[string]string `json:"parameters,omitempty" yaml:"parameters,omitempty"`
 }
 
 var Cmd = &cobra.Command{
@@ -52,12 +81,62 @@ func init() {
 		"",
 		"Name or ID of the cluster to list the add-ons of (required).",
 	)
+
+	flags.BoolVar(
+		&args.watch,
+		"watch",
+		false,
+		"Re-poll and re-render installed add-ons on an interval until interrupted (requires --cluster).",
+	)
+
+	flags.DurationVar(
+		&args.interval,
+		"interval",
+		10*time.Second,
+		"Polling interval used with --watch and --wait.",
+	)
+
+	flags.BoolVar(
+		&args.wait,
+		"wait",
+		false,
+		"Block until the add-on named by --addon reaches the state named by --for (requires --cluster).",
+	)
+
+	flags.StringVar(
+		&args.addonID,
+		"addon",
+		"",
+		"ID of the add-on to wait for. Used with --wait.",
+	)
+
+	flags.StringVar(
+		&args.forState,
+		"for",
+		"",
+		"State to wait for: 'ready', 'failed' or 'deleted'. Used with --wait.",
+	)
+
+	flags.DurationVar(
+		&args.timeout,
+		"timeout",
+		10*time.Minute,
+		"Maximum time to wait for the add-on to reach the desired state. Used with --wait.",
+	)
+
+	output.AddFlag(Cmd)
 }
 
-func run(_ *cobra.Command, _ []string) {
+func run(cmd *cobra.Command, _ []string) {
 	r := rosa.NewRuntime().WithAWS().WithOCM()
 	defer r.Cleanup()
 
+	outputFormat, err := output.Get(cmd)
+	if err != nil {
+		r.Reporter.Errorf("%v", err)
+		os.Exit(1)
+	}
+
 	// Check that the cluster key (name, identifier or external identifier) given by the user
 	// is reasonably safe so that there is no risk of SQL injection:
 	clusterKey := args.clusterKey
@@ -70,6 +149,26 @@ func run(_ *cobra.Command, _ []string) {
 		os.Exit(1)
 	}
 
+	if clusterKey == "" && (args.watch || args.wait) {
+		r.Reporter.Errorf("--watch and --wait require --cluster")
+		os.Exit(1)
+	}
+
+	if args.watch && args.wait {
+		r.Reporter.Errorf("--watch and --wait cannot be used together")
+		os.Exit(1)
+	}
+
+	if args.wait && (args.addonID == "" || args.forState == "") {
+		r.Reporter.Errorf("--wait requires --addon and --for")
+		os.Exit(1)
+	}
+
+	if args.wait && args.forState != "ready" && args.forState != "failed" && args.forState != "deleted" {
+		r.Reporter.Errorf("--for must be one of 'ready', 'failed' or 'deleted'")
+		os.Exit(1)
+	}
+
 	if clusterKey == "" {
 		r.Reporter.Debugf("Fetching all available add-ons")
 		addOnResources, err := r.OCMClient.GetAvailableAddOns()
@@ -82,15 +181,32 @@ func run(_ *cobra.Command, _ []string) {
 			os.Exit(0)
 		}
 
-		// Create the writer that will be used to print the tabulated results:
-		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintf(writer, "ID\t\tNAME\t\tAVAILABILITY\n")
+		addOns := make([]availableAddOnOutput, 0, len(addOnResources))
 		for _, addOnResource := range addOnResources {
 			availability := "unavailable"
 			if addOnResource.Available {
 				availability = "available"
 			}
-			fmt.Fprintf(writer, "%s\t\t%s\t\t%s\n", addOnResource.AddOn.ID(), addOnResource.AddOn.Name(), availability)
+			addOns = append(addOns, availableAddOnOutput{
+				ID:           addOnResource.AddOn.ID(),
+				Name:         addOnResource.AddOn.Name(),
+				Availability: availability,
+			})
+		}
+
+		if outputFormat != output.Table {
+			if err := output.Print(outputFormat, addOns); err != nil {
+				r.Reporter.Errorf("%v", err)
+				os.Exit(1)
+			}
+			os.Exit(0)
+		}
+
+		// Create the writer that will be used to print the tabulated results:
+		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(writer, "ID\t\tNAME\t\tAVAILABILITY\n")
+		for _, addOn := range addOns {
+			fmt.Fprintf(writer, "%s\t\t%s\t\t%s\n", addOn.ID, addOn.Name, addOn.Availability)
 		}
 		writer.Flush()
 
@@ -110,24 +226,160 @@ func run(_ *cobra.Command, _ []string) {
 		os.Exit(1)
 	}
 
-	// Load any existing Add-Ons for this cluster
-	r.Reporter.Debugf("Loading add-ons installations for cluster '%s'", clusterKey)
-	clusterAddOns, err := r.OCMClient.GetClusterAddOns(cluster)
+	if args.wait {
+		waitForAddOnState(r, cluster, clusterKey)
+		os.Exit(0)
+	}
+
+	if args.watch {
+		for {
+			addOns, err := fetchInstalledAddOns(r, cluster, clusterKey)
+			if err != nil {
+				r.Reporter.Errorf("%v", err)
+				os.Exit(1)
+			}
+			if outputFormat == output.Table {
+				fmt.Print("\033[H\033[2J")
+			}
+			renderInstalledAddOns(r, addOns, outputFormat)
+			time.Sleep(args.interval)
+		}
+	}
+
+	addOns, err := fetchInstalledAddOns(r, cluster, clusterKey)
 	if err != nil {
-		r.Reporter.Errorf("Failed to get add-ons for cluster '%s': %v", clusterKey, err)
+		r.Reporter.Errorf("%v", err)
 		os.Exit(1)
 	}
-
-	if len(clusterAddOns) == 0 {
+	if len(addOns) == 0 {
 		r.Reporter.Infof("There are no add-ons installed on cluster '%s'", clusterKey)
 		os.Exit(0)
 	}
+	renderInstalledAddOns(r, addOns, outputFormat)
+}
+
+// fetchInstalledAddOns loads the add-ons installed on the cluster and
+// converts them to the structured form shared by the table and
+// `--output json|yaml` views.
+func fetchInstalledAddOns(r *rosa.Runtime, cluster *cmv1.Cluster, clusterKey string) ([]installedAddOnOutput, error) {
+	r.Reporter.Debugf("Loading add-ons installations for cluster '%s'", clusterKey)
+	clusterAddOns, err := r.OCMClient.GetClusterAddOns(cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get add-ons for cluster '%s': %v", clusterKey, err)
+	}
+
+	addOns := make([]installedAddOnOutput, 0, len(clusterAddOns))
+	for _, clusterAddOn := range clusterAddOns {
+		addOns = append(addOns, installedAddOnOutput{
+			ID:         clusterAddOn.ID,
+			Name:       clusterAddOn.Name,
+			State:      clusterAddOn.State,
+			CreatedAt:  formatTime(clusterAddOn.CreatedAt),
+			UpdatedAt:  formatTime(clusterAddOn.UpdatedAt),
+			Parameters: clusterAddOn.Parameters,
+		})
+	}
+	return addOns, nil
+}
+
+// formatTime renders a timestamp as RFC 3339, or "" if it was never set, so
+// that `omitempty` can drop it from the JSON/YAML output.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// renderInstalledAddOns prints the installed add-ons either as a table or,
+// when an `--output` format was requested, as JSON/YAML.
+func renderInstalledAddOns(r *rosa.Runtime, addOns []installedAddOnOutput, outputFormat output.Format) {
+	if outputFormat != output.Table {
+		if err := output.Print(outputFormat, addOns); err != nil {
+			r.Reporter.Errorf("%v", err)
+			os.Exit(1)
+		}
+		return
+	}
 
 	// Create the writer that will be used to print the tabulated results:
 	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	fmt.Fprintf(writer, "ID\t\tNAME\t\tSTATE\n")
-	for _, clusterAddOn := range clusterAddOns {
-		fmt.Fprintf(writer, "%s\t\t%s\t\t%s\n", clusterAddOn.ID, clusterAddOn.Name, clusterAddOn.State)
+	for _, addOn := range addOns {
+		fmt.Fprintf(writer, "%s\t\t%s\t\t%s\n", addOn.ID, addOn.Name, addOn.State)
 	}
 	writer.Flush()
 }
+
+// waitOutcome is the result of checking a single poll of --wait's target
+// add-on/state against the current listing.
+type waitOutcome int
+
+const (
+	// waitPending means the add-on hasn't yet reached the target state (or
+	// failed) and polling should continue.
+	waitPending waitOutcome = iota
+	// waitReached means the add-on reached the target state.
+	waitReached
+	// waitFailed means the add-on failed before reaching the target state.
+	waitFailed
+)
+
+// evaluateWaitState checks the current add-on listing against --addon/--for
+// and reports whether the wait is done, and how. An add-on that no longer
+// appears in the listing at all is treated as having reached "deleted",
+// since that's what an uninstalled add-on looks like.
+func evaluateWaitState(addOns []installedAddOnOutput, addonID, forState string) waitOutcome {
+	found := false
+	for _, addOn := range addOns {
+		if addOn.ID != addonID {
+			continue
+		}
+		found = true
+		if addOn.State == forState {
+			return waitReached
+		}
+		if addOn.State == "failed" && forState != "failed" {
+			return waitFailed
+		}
+	}
+
+	if !found && forState == "deleted" {
+		return waitReached
+	}
+
+	return waitPending
+}
+
+// waitForAddOnState polls the add-on named by --addon until it reaches the
+// state named by --for, or until --timeout elapses, exiting non-zero on
+// failure or timeout.
+func waitForAddOnState(r *rosa.Runtime, cluster *cmv1.Cluster, clusterKey string) {
+	deadline := time.Now().Add(args.timeout)
+	for {
+		addOns, err := fetchInstalledAddOns(r, cluster, clusterKey)
+		if err != nil {
+			r.Reporter.Errorf("%v", err)
+			os.Exit(1)
+		}
+
+		switch evaluateWaitState(addOns, args.addonID, args.forState) {
+		case waitReached:
+			r.Reporter.Infof("Add-on '%s' reached state '%s'", args.addonID, args.forState)
+			return
+		case waitFailed:
+			r.Reporter.Errorf("Add-on '%s' failed while waiting for state '%s'", args.addonID, args.forState)
+			os.Exit(1)
+		}
+
+		if time.Now().After(deadline) {
+			r.Reporter.Errorf(
+				"Timed out after %s waiting for add-on '%s' to reach state '%s'",
+				args.timeout, args.addonID, args.forState,
+			)
+			os.Exit(1)
+		}
+
+		time.Sleep(args.interval)
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright (c) 2020 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import "testing"
+
+func TestEvaluateWaitState(t *testing.T) {
+	cases := []struct {
+		name     string
+		addOns   []installedAddOnOutput
+		addonID  string
+		forState string
+		want     waitOutcome
+	}{
+		{
+			name:     "reaches the target state",
+			addOns:   []installedAddOnOutput{{ID: "my-addon", State: "ready"}},
+			addonID:  "my-addon",
+			forState: "ready",
+			want:     waitReached,
+		},
+		{
+			name:     "still installing",
+			addOns:   []installedAddOnOutput{{ID: "my-addon", State: "installing"}},
+			addonID:  "my-addon",
+			forState: "ready",
+			want:     waitPending,
+		},
+		{
+			name:     "fails before reaching the target state",
+			addOns:   []installedAddOnOutput{{ID: "my-addon", State: "failed"}},
+			addonID:  "my-addon",
+			forState: "ready",
+			want:     waitFailed,
+		},
+		{
+			name:     "waiting for failed doesn't treat failed as a failure",
+			addOns:   []installedAddOnOutput{{ID: "my-addon", State: "failed"}},
+			addonID:  "my-addon",
+			forState: "failed",
+			want:     waitReached,
+		},
+		{
+			name:     "absence counts as deleted",
+			addOns:   []installedAddOnOutput{{ID: "other-addon", State: "ready"}},
+			addonID:  "my-addon",
+			forState: "deleted",
+			want:     waitReached,
+		},
+		{
+			name:     "absence is still pending for non-deleted states",
+			addOns:   []installedAddOnOutput{{ID: "other-addon", State: "ready"}},
+			addonID:  "my-addon",
+			forState: "ready",
+			want:     waitPending,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := evaluateWaitState(c.addOns, c.addonID, c.forState)
+			if got != c.want {
+				t.Fatalf("evaluateWaitState() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright (c) 2020 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logdelivery
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+var Cmd = &cobra.Command{
+	Use:     "log-deliveries",
+	Aliases: []string{"log-delivery"},
+	Short:   "List CloudWatch Logs deliveries",
+	Long:    "List the CloudWatch Logs deliveries configured in this account.",
+	Example: `  # List all log deliveries
+  rosa list log-deliveries`,
+	Run: run,
+}
+
+func run(_ *cobra.Command, _ []string) {
+	r := rosa.NewRuntime().WithAWS()
+	defer r.Cleanup()
+
+	client := aws.NewLogDeliveryClient(r.AWSClient.GetConfig())
+
+	deliveries, err := client.ListDeliveries(context.Background())
+	if err != nil {
+		r.Reporter.Errorf("Failed to list deliveries: %v", err)
+		os.Exit(1)
+	}
+
+	if len(deliveries) == 0 {
+		r.Reporter.Infof("There are no log deliveries configured")
+		os.Exit(0)
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(writer, "ID\t\tSOURCE\t\tDESTINATION\n")
+	for _, delivery := range deliveries {
+		fmt.Fprintf(writer, "%s\t\t%s\t\t%s\n",
+			aws.StringValue(delivery.Id), aws.StringValue(delivery.DeliverySourceName), aws.StringValue(delivery.DeliveryDestinationArn))
+	}
+	writer.Flush()
+}
@@ -0,0 +1,209 @@
+/*
+Copyright (c) 2020 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+)
+
+// LogDeliveryClient wraps the CloudWatch Logs Delivery API (delivery source,
+// delivery destination and delivery) so that ROSA cluster and add-on logs can
+// be forwarded cross-account to a CloudWatch Logs destination without the
+// operator having to hand-write the IAM resource policy involved.
+type LogDeliveryClient struct {
+	logs *cloudwatchlogs.Client
+}
+
+// NewLogDeliveryClient builds a LogDeliveryClient from the given AWS config.
+func NewLogDeliveryClient(cfg aws.Config) *LogDeliveryClient {
+	return &LogDeliveryClient{logs: cloudwatchlogs.NewFromConfig(cfg)}
+}
+
+// PutDeliverySource creates or updates a delivery source representing the
+// resource (cluster audit logs, infrastructure logs, or an add-on) that is
+// actually producing the logs.
+func (c *LogDeliveryClient) PutDeliverySource(
+	ctx context.Context, name, resourceARN, logType string,
+) (*types.DeliverySource, error) {
+	output, err := c.logs.PutDeliverySource(ctx, &cloudwatchlogs.PutDeliverySourceInput{
+		Name:        aws.String(name),
+		ResourceArn: aws.String(resourceARN),
+		LogType:     aws.String(logType),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create delivery source '%s': %v", name, err)
+	}
+	return output.DeliverySource, nil
+}
+
+// PutDeliveryDestination creates or updates a delivery destination pointing
+// at the CloudWatch Logs log group in the destination account.
+func (c *LogDeliveryClient) PutDeliveryDestination(
+	ctx context.Context, name, destinationARN string,
+) (*types.DeliveryDestination, error) {
+	output, err := c.logs.PutDeliveryDestination(ctx, &cloudwatchlogs.PutDeliveryDestinationInput{
+		Name:         aws.String(name),
+		OutputFormat: types.OutputFormatJson,
+		DeliveryDestinationConfiguration: &types.DeliveryDestinationConfiguration{
+			DestinationResourceArn: aws.String(destinationARN),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create delivery destination '%s': %v", name, err)
+	}
+	return output.DeliveryDestination, nil
+}
+
+// PutDeliveryDestinationPolicy attaches the cross-account resource policy
+// generated by BuildDeliveryDestinationPolicy to the named delivery
+// destination, authorizing deliveries from the source account.
+func (c *LogDeliveryClient) PutDeliveryDestinationPolicy(
+	ctx context.Context, destinationName, policy string,
+) error {
+	_, err := c.logs.PutDeliveryDestinationPolicy(ctx, &cloudwatchlogs.PutDeliveryDestinationPolicyInput{
+		DeliveryDestinationName:   aws.String(destinationName),
+		DeliveryDestinationPolicy: aws.String(policy),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to attach policy to delivery destination '%s': %v", destinationName, err)
+	}
+	return nil
+}
+
+// CreateDelivery pairs a previously created delivery source and delivery
+// destination, starting the actual flow of log events.
+func (c *LogDeliveryClient) CreateDelivery(
+	ctx context.Context, sourceName, destinationARN string,
+) (*types.Delivery, error) {
+	output, err := c.logs.CreateDelivery(ctx, &cloudwatchlogs.CreateDeliveryInput{
+		DeliverySourceName:     aws.String(sourceName),
+		DeliveryDestinationArn: aws.String(destinationARN),
+	})
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to create delivery from source '%s' to destination '%s': %v",
+			sourceName, destinationARN, err,
+		)
+	}
+	return output.Delivery, nil
+}
+
+// GetDelivery returns a single delivery by ID.
+func (c *LogDeliveryClient) GetDelivery(ctx context.Context, id string) (*types.Delivery, error) {
+	output, err := c.logs.GetDelivery(ctx, &cloudwatchlogs.GetDeliveryInput{Id: aws.String(id)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get delivery '%s': %v", id, err)
+	}
+	return output.Delivery, nil
+}
+
+// ListDeliveries returns every delivery in the account.
+func (c *LogDeliveryClient) ListDeliveries(ctx context.Context) ([]types.DeliveryInfo, error) {
+	var deliveries []types.DeliveryInfo
+	input := &cloudwatchlogs.DescribeDeliveriesInput{}
+	for {
+		output, err := c.logs.DescribeDeliveries(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list deliveries: %v", err)
+		}
+		deliveries = append(deliveries, output.Deliveries...)
+		if output.NextToken == nil {
+			return deliveries, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// DeleteDelivery removes the pairing between a delivery source and
+// destination, stopping the flow of log events.
+func (c *LogDeliveryClient) DeleteDelivery(ctx context.Context, id string) error {
+	_, err := c.logs.DeleteDelivery(ctx, &cloudwatchlogs.DeleteDeliveryInput{Id: aws.String(id)})
+	if err != nil {
+		return fmt.Errorf("failed to delete delivery '%s': %v", id, err)
+	}
+	return nil
+}
+
+// StringValue dereferences an optional AWS SDK string pointer, returning ""
+// rather than panicking when the field wasn't set.
+func StringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// policyStatement is a single statement of an IAM resource policy document.
+type policyStatement struct {
+	SID       string                 `json:"Sid"`
+	Effect    string                 `json:"Effect"`
+	Principal map[string]string      `json:"Principal"`
+	Action    string                 `json:"Action"`
+	Resource  string                 `json:"Resource"`
+	Condition map[string]interface{} `json:"Condition,omitempty"`
+}
+
+// policyDocument is the top level IAM policy document passed to
+// PutDeliveryDestinationPolicy.
+type policyDocument struct {
+	Version   string            `json:"Version"`
+	Statement []policyStatement `json:"Statement"`
+}
+
+// BuildDeliveryDestinationPolicy generates the two-statement IAM resource
+// policy required by PutDeliveryDestinationPolicy: one statement granting the
+// CloudWatch Logs delivery service general permission to deliver logs, and a
+// second statement scoping delivery from sourceAccount to destinationARN.
+func BuildDeliveryDestinationPolicy(destinationARN, sourceAccount string) (string, error) {
+	doc := policyDocument{
+		Version: "2012-10-17",
+		Statement: []policyStatement{
+			{
+				SID:       "EnableLogsDelivery",
+				Effect:    "Allow",
+				Principal: map[string]string{"Service": "delivery.logs.amazonaws.com"},
+				Action:    "logs:CreateDelivery",
+				Resource:  "*",
+				Condition: map[string]interface{}{
+					"StringEquals": map[string]string{"aws:SourceAccount": sourceAccount},
+				},
+			},
+			{
+				SID:       "EnableLogsDeliveryDestination",
+				Effect:    "Allow",
+				Principal: map[string]string{"Service": "delivery.logs.amazonaws.com"},
+				Action:    "logs:PutLogEvents",
+				Resource:  destinationARN,
+				Condition: map[string]interface{}{
+					"StringEquals": map[string]string{"aws:SourceAccount": sourceAccount},
+				},
+			},
+		},
+	}
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to build delivery destination policy: %v", err)
+	}
+	return string(data), nil
+}
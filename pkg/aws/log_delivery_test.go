@@ -0,0 +1,97 @@
+/*
+Copyright (c) 2020 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+func TestBuildDeliveryDestinationPolicy(t *testing.T) {
+	cases := []struct {
+		name           string
+		destinationARN string
+		sourceAccount  string
+	}{
+		{
+			name:           "typical destination and account",
+			destinationARN: "arn:aws:logs:us-east-1:222222222222:log-group:central-logs",
+			sourceAccount:  "111111111111",
+		},
+		{
+			name:           "empty inputs still produce a well-formed document",
+			destinationARN: "",
+			sourceAccount:  "",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			policy, err := BuildDeliveryDestinationPolicy(c.destinationARN, c.sourceAccount)
+			if err != nil {
+				t.Fatalf("BuildDeliveryDestinationPolicy returned unexpected error: %v", err)
+			}
+
+			var doc policyDocument
+			if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+				t.Fatalf("policy isn't valid JSON: %v", err)
+			}
+
+			if doc.Version != "2012-10-17" {
+				t.Fatalf("Version = %q, want %q", doc.Version, "2012-10-17")
+			}
+
+			if len(doc.Statement) != 2 {
+				t.Fatalf("expected 2 statements, got %d", len(doc.Statement))
+			}
+
+			general := doc.Statement[0]
+			if general.Action != "logs:CreateDelivery" {
+				t.Fatalf("general statement Action = %q, want %q", general.Action, "logs:CreateDelivery")
+			}
+
+			destination := doc.Statement[1]
+			if destination.Action != "logs:PutLogEvents" {
+				t.Fatalf("destination statement Action = %q, want %q", destination.Action, "logs:PutLogEvents")
+			}
+			if destination.Resource != c.destinationARN {
+				t.Fatalf("destination statement Resource = %q, want %q", destination.Resource, c.destinationARN)
+			}
+
+			for _, statement := range doc.Statement {
+				if !strings.Contains(policy, c.sourceAccount) && c.sourceAccount != "" {
+					t.Fatalf("policy does not reference source account %q: %s", c.sourceAccount, policy)
+				}
+				if statement.Principal["Service"] != "delivery.logs.amazonaws.com" {
+					t.Fatalf("statement Principal = %v, want delivery.logs.amazonaws.com", statement.Principal)
+				}
+			}
+		})
+	}
+}
+
+func TestStringValue(t *testing.T) {
+	if got := StringValue(nil); got != "" {
+		t.Fatalf("StringValue(nil) = %q, want \"\"", got)
+	}
+	if got := StringValue(aws.String("my-delivery")); got != "my-delivery" {
+		t.Fatalf("StringValue(&\"my-delivery\") = %q, want %q", got, "my-delivery")
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright (c) 2020 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package output provides a small shared helper for commands that can render
+// their result as a plain table or as structured JSON/YAML, so that the same
+// flag and marshalling logic doesn't need to be reimplemented by every
+// command that wants scriptable output.
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+// Format of the output that a command should render.
+type Format string
+
+const (
+	// Table renders a human readable, tab-aligned table. This is the default.
+	Table Format = "table"
+
+	// JSON renders the result as a JSON array/object.
+	JSON Format = "json"
+
+	// YAML renders the result as YAML.
+	YAML Format = "yaml"
+)
+
+// Flag is the name of the flag added by AddFlag.
+const Flag = "output"
+
+// AddFlag registers the `--output` flag on the given command, accepting
+// `table`, `json` or `yaml`. Commands should read the value back with Get.
+func AddFlag(cmd *cobra.Command) {
+	cmd.Flags().StringP(
+		Flag,
+		"o",
+		string(Table),
+		"Output format. Allowed formats are 'table', 'json' and 'yaml'.",
+	)
+}
+
+// Get returns the output format requested on the command line, validating
+// that it is one of the supported values.
+func Get(cmd *cobra.Command) (Format, error) {
+	value, err := cmd.Flags().GetString(Flag)
+	if err != nil {
+		return "", err
+	}
+
+	format := Format(value)
+	switch format {
+	case Table, JSON, YAML:
+		return format, nil
+	default:
+		return "", fmt.Errorf(
+			"invalid output format '%s': must be one of 'table', 'json' or 'yaml'",
+			value,
+		)
+	}
+}
+
+// Print marshals the given value as JSON or YAML and writes it to stdout.
+// It is the caller's responsibility to only call this for the JSON/YAML
+// formats; `table` output has no generic representation and should
+// continue to be rendered by the caller.
+func Print(format Format, value interface{}) error {
+	switch format {
+	case JSON:
+		data, err := json.MarshalIndent(value, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal output as JSON: %v", err)
+		}
+		fmt.Fprintln(os.Stdout, string(data))
+	case YAML:
+		data, err := yaml.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("failed to marshal output as YAML: %v", err)
+		}
+		fmt.Fprint(os.Stdout, string(data))
+	default:
+		return fmt.Errorf("unsupported output format '%s'", format)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+/*
+Copyright (c) 2020 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestGet(t *testing.T) {
+	cases := []struct {
+		name    string
+		value   string
+		want    Format
+		wantErr bool
+	}{
+		{name: "accepts table", value: "table", want: Table},
+		{name: "accepts json", value: "json", want: JSON},
+		{name: "accepts yaml", value: "yaml", want: YAML},
+		{name: "rejects unknown format", value: "xml", wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			AddFlag(cmd)
+			if err := cmd.Flags().Set(Flag, c.value); err != nil {
+				t.Fatalf("failed to set --%s: %v", Flag, err)
+			}
+
+			got, err := Get(cmd)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("Get(%q) expected an error, got none", c.value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Get(%q) returned unexpected error: %v", c.value, err)
+			}
+			if got != c.want {
+				t.Fatalf("Get(%q) = %q, want %q", c.value, got, c.want)
+			}
+		})
+	}
+}
+
+func TestPrintRejectsTable(t *testing.T) {
+	if err := Print(Table, struct{}{}); err == nil {
+		t.Fatal("Print(Table, ...) expected an error, got none")
+	}
+}
+
+func TestPrintJSONAndYAML(t *testing.T) {
+	value := struct {
+		Name string `json:"name" yaml:"name"`
+	}{Name: "my-addon"}
+
+	if err := Print(JSON, value); err != nil {
+		t.Fatalf("Print(JSON, ...) returned unexpected error: %v", err)
+	}
+	if err := Print(YAML, value); err != nil {
+		t.Fatalf("Print(YAML, ...) returned unexpected error: %v", err)
+	}
+}